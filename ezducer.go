@@ -0,0 +1,64 @@
+package transduce
+
+// StopMarker is a sentinel value that may be included in the slice
+// returned from an Ez's Step or Result to request early termination of
+// the reduction, once the values preceding it have been processed.
+var StopMarker = &struct{ name string }{"transduce.StopMarker"}
+
+// Ez is the set of callbacks an Ezducer-built transducer may implement.
+// Step is called once per input and may yield zero or more values
+// downstream; Result is called once, during the arity-1 call, to flush
+// any values buffered by Step. Either field may be left nil.
+type Ez struct {
+	Step   func(v interface{}) []interface{}
+	Result func() []interface{}
+}
+
+// Ezducer builds a Transducer from a constructor that returns a fresh Ez
+// for each application, capturing whatever state the Ez needs as
+// closed-over variables. This removes the need to hand-write the
+// Reduced/EnsureReduced and partition-carry-over bookkeeping that
+// stateful transducers otherwise require; compare to the manual
+// bookkeeping in Batch and Interpose. Because constructor runs
+// once per application and the Ez it returns is free to close over
+// mutable state that persists across Step calls, every Ezducer-built
+// transducer is marked stateful; consumers like stream.Parallel fall
+// back to a single worker for it just as they do for Take or Dedupe.
+func Ezducer(constructor func() Ez) Transducer {
+	return markStateful(func(rf ReducerFn) ReducerFn {
+		ez := constructor()
+		return Reducer(
+			func() interface{} {
+				return rf.Init()
+			},
+			func(result interface{}) interface{} {
+				ret := result
+				if ez.Result != nil {
+					ret = emit(rf, ret, ez.Result())
+				}
+				return rf.Result(Unreduced(ret))
+			},
+			func(result, input interface{}) interface{} {
+				if ez.Step == nil {
+					return result
+				}
+				return emit(rf, result, ez.Step(input))
+			},
+		)
+	})
+}
+
+// emit feeds each value in vs through rf.Step in turn, stopping early if
+// a step becomes Reduced or StopMarker is encountered.
+func emit(rf ReducerFn, result interface{}, vs []interface{}) interface{} {
+	for _, v := range vs {
+		if v == StopMarker {
+			return EnsureReduced(result)
+		}
+		result = rf.Step(result, v)
+		if IsReduced(result) {
+			return result
+		}
+	}
+	return result
+}
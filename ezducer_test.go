@@ -0,0 +1,149 @@
+package transduce
+
+import "fmt"
+
+func ExampleEzducer() {
+	// This ezducer drops all inputs and yields nothing.
+	_ = Ezducer(func() Ez {
+		return Ez{
+			Step: func(v interface{}) []interface{} {
+				return nil
+			},
+		}
+	})
+}
+
+// takeEz is Take re-expressed as an Ezducer, for comparison against the
+// manual Reduced/EnsureReduced bookkeeping in Take itself.
+func takeEz(n int) Transducer {
+	return Ezducer(func() Ez {
+		count := n
+		return Ez{
+			Step: func(v interface{}) []interface{} {
+				if count <= 0 {
+					return []interface{}{StopMarker}
+				}
+				count--
+				if count == 0 {
+					return []interface{}{v, StopMarker}
+				}
+				return []interface{}{v}
+			},
+		}
+	})
+}
+
+// takeWhileEz is TakeWhile re-expressed as an Ezducer.
+func takeWhileEz(pred func(interface{}) bool) Transducer {
+	return Ezducer(func() Ez {
+		return Ez{
+			Step: func(v interface{}) []interface{} {
+				if pred(v) {
+					return []interface{}{v}
+				}
+				return []interface{}{StopMarker}
+			},
+		}
+	})
+}
+
+// filterEz is Filter re-expressed as an Ezducer.
+func filterEz(pred func(interface{}) bool) Transducer {
+	return Ezducer(func() Ez {
+		return Ez{
+			Step: func(v interface{}) []interface{} {
+				if pred(v) {
+					return []interface{}{v}
+				}
+				return nil
+			},
+		}
+	})
+}
+
+// interposeEz is Interpose re-expressed as an Ezducer.
+func interposeEz(sep interface{}) Transducer {
+	return Ezducer(func() Ez {
+		started := false
+		return Ez{
+			Step: func(v interface{}) []interface{} {
+				if !started {
+					started = true
+					return []interface{}{v}
+				}
+				return []interface{}{sep, v}
+			},
+		}
+	})
+}
+
+// partitionAllEz is PartitionAll re-expressed as an Ezducer.
+func partitionAllEz(n int) Transducer {
+	return Ezducer(func() Ez {
+		part := make([]interface{}, 0, n)
+		flush := func() []interface{} {
+			if len(part) == 0 {
+				return nil
+			}
+			cpy := make([]interface{}, len(part))
+			copy(cpy, part)
+			part = part[:0]
+			return []interface{}{cpy}
+		}
+		return Ez{
+			Step: func(v interface{}) []interface{} {
+				part = append(part, v)
+				if len(part) == n {
+					return flush()
+				}
+				return nil
+			},
+			Result: flush,
+		}
+	})
+}
+
+func ExampleEzducer_take() {
+	fmt.Println(Transduce(takeEz(3), collector(), []int{1, 2, 3, 4, 5}))
+	// Output: [1 2 3]
+}
+
+func ExampleEzducer_takeWhile() {
+	fmt.Println(Transduce(
+		takeWhileEz(func(v interface{}) bool { return v.(int) < 4 }),
+		collector(),
+		[]int{1, 2, 3, 4, 5},
+	))
+	// Output: [1 2 3]
+}
+
+func ExampleEzducer_filter() {
+	fmt.Println(Transduce(
+		filterEz(func(v interface{}) bool { return v.(int)%2 == 0 }),
+		collector(),
+		[]int{1, 2, 3, 4, 5, 6},
+	))
+	// Output: [2 4 6]
+}
+
+func ExampleEzducer_interpose() {
+	fmt.Println(Transduce(interposeEz("/"), collector(), []int{1, 2, 3}))
+	// Output: [1 / 2 / 3]
+}
+
+func ExampleEzducer_partitionAll() {
+	fmt.Println(Transduce(partitionAllEz(3), collector(), []int{1, 2, 3, 4, 5, 6, 7}))
+	// Output: [[1 2 3] [4 5 6] [7]]
+}
+
+// collector is a ReducerFn that appends every input to a growing slice,
+// used throughout the example tests.
+func collector() ReducerFn {
+	return Reducer(
+		func() interface{} { return []interface{}{} },
+		func(result interface{}) interface{} { return result },
+		func(result, input interface{}) interface{} {
+			return append(result.([]interface{}), input)
+		},
+	)
+}
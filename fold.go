@@ -0,0 +1,106 @@
+package transduce
+
+// Fold returns a stateful transducer that maintains a single accumulator
+// across the input stream. On each input it sets acc = step(acc, input)
+// and emits result(acc) downstream; if result returns nil nothing is
+// emitted for that input. seed is the accumulator's initial value. step
+// must match the signature func(a aT, i iT) aT and result must match
+// func(a aT) oT.
+func Fold(step interface{}, result interface{}, seed interface{}) Transducer {
+	stepFn := wrapReducing(step)
+	resultFn := wrapResult(result)
+	return markStateful(func(rf ReducerFn) ReducerFn {
+		acc := seed
+		return Reducing(
+			func(res, input interface{}) interface{} {
+				acc = stepFn(acc, input)
+				out := resultFn(acc)
+				if out == nil {
+					return res
+				}
+				return rf.Step(res, out)
+			},
+		)(rf)
+	})
+}
+
+// Batch returns a stateful transducer that folds inputs into a batch
+// accumulator, starting from seed, until pred(batch, input) reports that
+// the batch is full. At that point flush(batch) is emitted downstream
+// and a new batch is started from seed with the triggering input merged
+// in via merge. At completion, any non-empty batch is flushed. pred must
+// match the signature func(batch bT, i iT) bool, merge must match
+// func(batch bT, i iT) bT, and flush must match func(batch bT) oT.
+func Batch(pred interface{}, merge interface{}, flush interface{}, seed interface{}) Transducer {
+	predFn := wrapBatchPred(pred)
+	mergeFn := wrapReducing(merge)
+	flushFn := wrapResult(flush)
+	return markStateful(func(rf ReducerFn) ReducerFn {
+		batch := seed
+		empty := true
+		return Reducer(
+			func() interface{} {
+				return rf.Init()
+			},
+			func(result interface{}) interface{} {
+				ret := result
+				if !empty {
+					out := flushFn(batch)
+					batch, empty = seed, true
+					ret = Unreduced(rf.Step(result, out))
+				}
+				return rf.Result(ret)
+			},
+			func(result, input interface{}) interface{} {
+				if !empty && predFn(batch, input) {
+					ret := rf.Step(result, flushFn(batch))
+					if IsReduced(ret) {
+						return ret
+					}
+					batch, empty = mergeFn(seed, input), false
+					return ret
+				}
+				batch, empty = mergeFn(batch, input), false
+				return result
+			},
+		)
+	})
+}
+
+// wrapBatchPred adapts a batch-full predicate to its specialized
+// signature, falling back to reflection unless f is already of the
+// non-specialized type func(interface{}, interface{}) bool.
+func wrapBatchPred(f interface{}) func(batch, input interface{}) bool {
+	switch fn := f.(type) {
+	case func(batch, input interface{}) bool:
+		return fn
+	default:
+		return func(batch, input interface{}) bool {
+			return apply(f, batch, input).(bool)
+		}
+	}
+}
+
+// Window returns a stateful transducer that emits overlapping windows of
+// size n as the stream is consumed, once at least n elements have been
+// seen. It is implemented in terms of Fold to demonstrate the pattern.
+func Window(n int) Transducer {
+	return Fold(
+		func(buf []interface{}, input interface{}) []interface{} {
+			buf = append(buf, input)
+			if len(buf) > n {
+				buf = buf[1:]
+			}
+			return buf
+		},
+		func(buf []interface{}) interface{} {
+			if len(buf) < n {
+				return nil
+			}
+			cpy := make([]interface{}, n)
+			copy(cpy, buf)
+			return cpy
+		},
+		[]interface{}{},
+	)
+}
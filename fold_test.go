@@ -0,0 +1,42 @@
+package transduce
+
+import "fmt"
+
+func ExampleFold() {
+	// This transducer emits a running sum of all inputs seen so far.
+	xf := Fold(
+		func(sum, input int) int { return sum + input },
+		func(sum int) interface{} { return sum },
+		0,
+	)
+	fmt.Println(Into([]interface{}{}, xf, []int{1, 2, 3, 4}))
+	// Output: [1 3 6 10]
+}
+
+func ExampleBatch() {
+	// This transducer batches inputs until their running total reaches
+	// 10, then flushes the batch.
+	xf := Batch(
+		func(batch []int, input int) bool {
+			total := 0
+			for _, v := range batch {
+				total += v
+			}
+			return total >= 10
+		},
+		func(batch []int, input int) []int {
+			return append(batch, input)
+		},
+		func(batch []int) interface{} {
+			return batch
+		},
+		[]int{},
+	)
+	fmt.Println(Into([]interface{}{}, xf, []int{4, 5, 3, 8, 2}))
+	// Output: [[4 5 3] [8 2]]
+}
+
+func ExampleWindow() {
+	fmt.Println(Into([]interface{}{}, Window(3), []int{1, 2, 3, 4, 5}))
+	// Output: [[1 2 3] [2 3 4] [3 4 5]]
+}
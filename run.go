@@ -0,0 +1,190 @@
+package transduce
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// Reduce is implemented by any collection that knows how to perform its
+// own reduction. Transduce and Into use it directly instead of falling
+// back to reflection when coll implements it.
+type Reduce interface {
+	Reduce(step func(result, input interface{}) interface{}, init interface{}) interface{}
+}
+
+// Transduce runs xf over coll, threading rf's Init through the
+// reduction, honoring Reduced results for early termination, and calling
+// rf.Result on completion. coll may be a slice, array, map, channel, or
+// any value implementing Reduce. Map entries are passed to rf.Step as
+// [2]interface{}{key, value}.
+func Transduce(xf Transducer, rf ReducerFn, coll interface{}) interface{} {
+	step := xf(rf)
+	result := reduceCollection(step, step.Init(), coll)
+	return step.Result(Unreduced(result))
+}
+
+// reduceCollection walks coll, feeding each element to step.Step, and
+// returns the (possibly Reduced) final accumulator.
+func reduceCollection(step ReducerFn, result interface{}, coll interface{}) interface{} {
+	if r, ok := coll.(Reduce); ok {
+		return r.Reduce(step.Step, result)
+	}
+	v := reflect.ValueOf(coll)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len() && !IsReduced(result); i++ {
+			result = step.Step(result, v.Index(i).Interface())
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() && !IsReduced(result) {
+			result = step.Step(result, [2]interface{}{
+				iter.Key().Interface(),
+				iter.Value().Interface(),
+			})
+		}
+	case reflect.Chan:
+		for !IsReduced(result) {
+			x, ok := v.Recv()
+			if !ok {
+				break
+			}
+			result = step.Step(result, x.Interface())
+		}
+	default:
+		panic(fmt.Sprintf("transduce: Transduce: unsupported collection type %T", coll))
+	}
+	return result
+}
+
+// conj appends input to the running result, where result is a slice, a
+// map being populated from [2]interface{}{key, value} pairs, or a
+// channel being sent on. It mirrors the destinations Into supports and
+// is used as Into's Step function.
+func conj(result, input interface{}) interface{} {
+	switch r := result.(type) {
+	case []interface{}:
+		return append(r, input)
+	}
+	v := reflect.ValueOf(result)
+	switch v.Kind() {
+	case reflect.Slice:
+		return reflect.Append(v, reflect.ValueOf(input)).Interface()
+	case reflect.Map:
+		pair := reflect.ValueOf(input)
+		// pair.Index(0/1) are reflect.Values of static type
+		// interface{}; SetMapIndex requires the key/value's static
+		// type to be assignable to the map's key/value type, so for
+		// any concretely-typed map (map[string]int, not just
+		// map[interface{}]interface{}) they must be rematerialized
+		// from their dynamic value first.
+		v.SetMapIndex(
+			reflect.ValueOf(pair.Index(0).Interface()),
+			reflect.ValueOf(pair.Index(1).Interface()),
+		)
+		return result
+	case reflect.Chan:
+		v.Send(reflect.ValueOf(input))
+		return result
+	default:
+		panic(fmt.Sprintf("transduce: Into: unsupported destination type %T", result))
+	}
+}
+
+// Into reduces xf over src and accumulates each output into dest via
+// conj, returning the populated dest. dest may be a slice (appended to),
+// a map (populated from [2]interface{}{key, value} pairs), or a channel
+// (sent on).
+func Into(dest interface{}, xf Transducer, src interface{}) interface{} {
+	rf := Reducer(
+		func() interface{} { return dest },
+		func(result interface{}) interface{} { return result },
+		conj,
+	)
+	return Transduce(xf, rf, src)
+}
+
+// eduction is the concrete type returned by Eduction.
+type eduction struct {
+	xf  Transducer
+	src interface{}
+}
+
+// Eduction returns a lazy, reusable view of xf applied to src: no work is
+// done until the eduction is consumed, and each consumption re-runs xf
+// over src from the beginning. The result implements Reduce, so it can
+// be passed as the coll argument to Transduce or nested as the src of
+// another Eduction, and can be driven directly with Seq.
+func Eduction(xf Transducer, src interface{}) *eduction {
+	return &eduction{xf: xf, src: src}
+}
+
+// Reduce implements the Reduce interface by running step, seeded with
+// init, over xf applied to src.
+func (e *eduction) Reduce(step func(result, input interface{}) interface{}, init interface{}) interface{} {
+	rf := Reducer(
+		func() interface{} { return init },
+		func(result interface{}) interface{} { return result },
+		step,
+	)
+	return Transduce(e.xf, rf, e.src)
+}
+
+// Seq is a shim for the iter.Seq[interface{}] function shape introduced
+// in Go 1.23, letting an Eduction be consumed with range-over-func on
+// toolchains that support it, or driven manually by calling seq(yield).
+type Seq func(yield func(interface{}) bool)
+
+// Seq returns e as a Seq: each call re-runs the transducer over src,
+// calling yield for every output value and stopping early if yield
+// returns false.
+func (e *eduction) Seq() Seq {
+	return func(yield func(interface{}) bool) {
+		e.Reduce(func(result, input interface{}) interface{} {
+			if !yield(input) {
+				return Reduced(result)
+			}
+			return result
+		}, nil)
+	}
+}
+
+// Chan returns a channel that streams the result of applying xf to
+// values received from in. A single goroutine drives the reduction; the
+// returned channel is closed when in is closed, ctx is done, or the
+// transducer's reduction terminates via Reduced.
+func Chan(ctx context.Context, xf Transducer, in <-chan interface{}) <-chan interface{} {
+	out := make(chan interface{})
+	go func() {
+		defer close(out)
+		rf := xf(Reducer(
+			func() interface{} { return nil },
+			func(result interface{}) interface{} { return result },
+			func(result, input interface{}) interface{} {
+				select {
+				case out <- input:
+					return result
+				case <-ctx.Done():
+					return Reduced(result)
+				}
+			},
+		))
+		result := rf.Init()
+		for !IsReduced(result) {
+			select {
+			case v, ok := <-in:
+				if !ok {
+					rf.Result(Unreduced(result))
+					return
+				}
+				result = rf.Step(result, v)
+			case <-ctx.Done():
+				rf.Result(Unreduced(result))
+				return
+			}
+		}
+		rf.Result(Unreduced(result))
+	}()
+	return out
+}
@@ -0,0 +1,89 @@
+package transduce
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+func ExampleTransduce() {
+	result := Transduce(
+		Map(func(x int) int { return x * x }),
+		Reducer(
+			func() interface{} { return 0 },
+			func(result interface{}) interface{} { return result },
+			func(result, input interface{}) interface{} {
+				return result.(int) + input.(int)
+			},
+		),
+		[]int{1, 2, 3, 4},
+	)
+	fmt.Println(result)
+	// Output: 30
+}
+
+func ExampleInto() {
+	result := Into(
+		[]interface{}{},
+		Filter(func(x int) bool { return x%2 == 0 }),
+		[]int{1, 2, 3, 4, 5, 6},
+	)
+	fmt.Println(result)
+	// Output: [2 4 6]
+}
+
+// ExampleInto_typedMap checks that a destination map with concrete key
+// and value types, not just map[interface{}]interface{}, can be
+// populated by Into: conj must rematerialize each [2]interface{} pair's
+// dynamic value before calling reflect.Value.SetMapIndex, since that
+// call requires the pair's static type to match the map's.
+func ExampleInto_typedMap() {
+	result := Into(
+		map[string]int{},
+		Map(func(x int) [2]interface{} { return [2]interface{}{fmt.Sprint(x), x * x} }),
+		[]int{1, 2, 3},
+	).(map[string]int)
+
+	keys := make([]string, 0, len(result))
+	for k := range result {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Println(k, result[k])
+	}
+	// Output:
+	// 1 1
+	// 2 4
+	// 3 9
+}
+
+func ExampleEduction() {
+	ed := Eduction(Map(func(x int) int { return x + 1 }), []int{1, 2, 3})
+	ed.Seq()(func(v interface{}) bool {
+		fmt.Println(v)
+		return true
+	})
+	// Output:
+	// 2
+	// 3
+	// 4
+}
+
+func ExampleChan() {
+	in := make(chan interface{})
+	go func() {
+		defer close(in)
+		for i := 1; i <= 3; i++ {
+			in <- i
+		}
+	}()
+	out := Chan(context.Background(), Map(func(x int) int { return x * 2 }), in)
+	for v := range out {
+		fmt.Println(v)
+	}
+	// Output:
+	// 2
+	// 4
+	// 6
+}
@@ -0,0 +1,64 @@
+package transduce
+
+// StatefulTransducer is implemented by any Transducer that carries state
+// across calls to Step, such as the Transducer values returned by
+// Dedupe, Take, TakeWhile, TakeNth, Drop, DropWhile, KeepIndexed,
+// PartitionBy, PartitionAll, and Interpose. Consumers like
+// stream.Parallel use Stateful to decide whether a pipeline must be
+// funneled through a single worker instead of distributed across a
+// pool.
+type StatefulTransducer interface {
+	Stateful() bool
+}
+
+// statefulProbe is a ReducerFn used only by markStateful and Stateful to
+// detect statefulness by actually calling the transducer, rather than by
+// comparing reflect.Value.Pointer() of the Transducer func values.
+// Pointer() on a func returns its underlying code pointer, which is
+// shared by every closure instantiated from the same func literal
+// regardless of what each instance captured; keying a registry on it
+// conflates every Take, Dedupe, etc. built from the same call site,
+// so a stateful transducer built once can mark every other transducer
+// from that call site stateful forever. Passing a probe value through
+// the transducer instead observes the one property that actually
+// varies: whether markStateful's wrapper is present in the chain that
+// was called.
+type statefulProbe struct {
+	stateful bool
+}
+
+func (p *statefulProbe) Init() interface{}                     { return nil }
+func (p *statefulProbe) Result(result interface{}) interface{} { return result }
+func (p *statefulProbe) Step(result, input interface{}) interface{} {
+	return result
+}
+
+// markStateful records that xf carries state across Step calls and
+// returns a wrapper that otherwise behaves exactly like xf, so a
+// stateful constructor can write
+// `return markStateful(func(rf ReducerFn) ReducerFn { ... })`. When
+// probed by Stateful, the wrapper reports the mark and returns without
+// ever calling xf, so querying Stateful on a transducer built from a
+// side-effecting constructor (Ezducer's, for instance) never runs that
+// constructor just to answer the question.
+func markStateful(xf Transducer) Transducer {
+	return func(rf ReducerFn) ReducerFn {
+		if p, ok := rf.(*statefulProbe); ok {
+			p.stateful = true
+			return p
+		}
+		return xf(rf)
+	}
+}
+
+// Stateful reports whether t was built by one of the package's stateful
+// transducer constructors. Transducers assembled from only stateless
+// pieces (Map, Filter, Remove, Keep, Replace, ...) report false. It
+// works by calling t with a probe ReducerFn and checking whether any
+// markStateful wrapper in the chain saw it go by, so statefulness is
+// determined per Transducer value rather than per call site.
+func (t Transducer) Stateful() bool {
+	p := &statefulProbe{}
+	t(p)
+	return p.stateful
+}
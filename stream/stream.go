@@ -0,0 +1,205 @@
+// Package stream runs a transduce.Transducer over Go channels, adding
+// context cancellation, worker parallelism for stateless pipelines, and
+// fan-out/fan-in topologies on top of the single-goroutine
+// transduce.Chan driver.
+package stream
+
+import (
+	"context"
+	"sync"
+
+	"jsouthworth.net/go/transduce"
+)
+
+// Pipe runs xf over values received from in on a single goroutine,
+// honoring ctx.Done() and Reduced early termination. It is a thin
+// wrapper over transduce.Chan, provided for symmetry with Parallel, Tee,
+// and Merge.
+func Pipe(ctx context.Context, xf transduce.Transducer, in <-chan interface{}) <-chan interface{} {
+	return transduce.Chan(ctx, xf, in)
+}
+
+// skip is a private sentinel used by Parallel to recognize when a
+// worker's reduction produced no output for an input, as opposed to an
+// output that happens to be nil.
+var skip = &struct{ name string }{"stream.skip"}
+
+// Parallel runs xf over values received from in using n worker
+// goroutines and merges their results onto the returned channel. Each
+// worker builds xf's reducer once and runs every input it receives
+// through its own Init/Step/Result call, so a stateful xf (reported via
+// the StatefulTransducer capability it implements, see transduce.Dedupe,
+// Take, PartitionBy, ...) cannot be split across workers without
+// corrupting its accumulator, or across per-item Init/Result calls
+// without corrupting its Reduced/multi-emit semantics. Parallel detects
+// this and funnels a stateful xf through Pipe instead -- the same
+// single-goroutine, full-stream driver a caller would reach for by
+// hand -- rather than distributing it across the requested pool.
+//
+// Parallel assumes a stateless xf produces at most one output per
+// input, which holds for Map, Filter, Remove, Keep, and Replace but not
+// for transducers like Cat or Mapcat that can expand one input into
+// many; those are not supported here, stateful or not.
+//
+// If preserveOrder is true, outputs are reordered to match the order
+// their inputs were received on in, buffering results that complete out
+// of order until their turn comes up. It has no effect when xf is
+// stateful, since Pipe already preserves order.
+func Parallel(
+	ctx context.Context,
+	xf transduce.Transducer,
+	in <-chan interface{},
+	n int,
+	preserveOrder bool,
+) <-chan interface{} {
+	var stateful transduce.StatefulTransducer = xf
+	if stateful.Stateful() {
+		return Pipe(ctx, xf, in)
+	}
+
+	type item struct {
+		seq     int
+		val     interface{}
+		skipped bool
+	}
+	work := make(chan item)
+	results := make(chan item)
+
+	var workers sync.WaitGroup
+	workers.Add(n)
+	for i := 0; i < n; i++ {
+		go func() {
+			defer workers.Done()
+			rf := xf(transduce.Reducer(
+				func() interface{} { return skip },
+				func(result interface{}) interface{} { return result },
+				func(_, input interface{}) interface{} { return input },
+			))
+			for it := range work {
+				out := rf.Result(transduce.Unreduced(rf.Step(rf.Init(), it.val)))
+				// Always send a result, even for filtered-out
+				// inputs, so the order-preserving path below sees
+				// every seq number and never stalls waiting for
+				// one that will never arrive.
+				select {
+				case results <- item{seq: it.seq, val: out, skipped: out == skip}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		workers.Wait()
+		close(results)
+	}()
+
+	go func() {
+		defer close(work)
+		seq := 0
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok {
+					return
+				}
+				select {
+				case work <- item{seq: seq, val: v}:
+					seq++
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	out := make(chan interface{})
+	go func() {
+		defer close(out)
+		if !preserveOrder {
+			for r := range results {
+				if r.skipped {
+					continue
+				}
+				select {
+				case out <- r.val:
+				case <-ctx.Done():
+					return
+				}
+			}
+			return
+		}
+		pending := map[int]item{}
+		next := 0
+		for r := range results {
+			pending[r.seq] = r
+			for p, ok := pending[next]; ok; p, ok = pending[next] {
+				delete(pending, next)
+				next++
+				if p.skipped {
+					continue
+				}
+				select {
+				case out <- p.val:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out
+}
+
+// Tee runs xf over values received from in and forwards every output to
+// each of outs, blocking on the slowest consumer. All of outs are closed
+// once in is exhausted, ctx is done, or the reduction terminates via
+// Reduced.
+func Tee(ctx context.Context, xf transduce.Transducer, in <-chan interface{}, outs ...chan<- interface{}) {
+	go func() {
+		defer func() {
+			for _, o := range outs {
+				close(o)
+			}
+		}()
+		for v := range Pipe(ctx, xf, in) {
+			for _, o := range outs {
+				select {
+				case o <- v:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+}
+
+// Merge applies xf independently to each of ins and fans the results
+// into a single returned channel, which is closed once every input is
+// exhausted. If xf is stateful, each input channel gets its own
+// independent state; nothing is shared across ins.
+func Merge(ctx context.Context, xf transduce.Transducer, ins ...<-chan interface{}) <-chan interface{} {
+	out := make(chan interface{})
+	var producers sync.WaitGroup
+	producers.Add(len(ins))
+	for _, in := range ins {
+		in := in
+		go func() {
+			defer producers.Done()
+			for v := range Pipe(ctx, xf, in) {
+				select {
+				case out <- v:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		producers.Wait()
+		close(out)
+	}()
+	return out
+}
@@ -0,0 +1,144 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"jsouthworth.net/go/transduce"
+)
+
+func ints(ctx context.Context, vs ...int) <-chan interface{} {
+	out := make(chan interface{})
+	go func() {
+		defer close(out)
+		for _, v := range vs {
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+func ExamplePipe() {
+	ctx := context.Background()
+	out := Pipe(ctx, transduce.Map(func(x int) int { return x * 2 }), ints(ctx, 1, 2, 3))
+	for v := range out {
+		fmt.Println(v)
+	}
+	// Output:
+	// 2
+	// 4
+	// 6
+}
+
+func ExampleParallel() {
+	ctx := context.Background()
+	out := Parallel(
+		ctx,
+		transduce.Filter(func(x int) bool { return x%2 == 0 }),
+		ints(ctx, 1, 2, 3, 4, 5, 6),
+		4,
+		true,
+	)
+	var got []int
+	for v := range out {
+		got = append(got, v.(int))
+	}
+	sort.Ints(got)
+	fmt.Println(got)
+	// Output: [2 4 6]
+}
+
+// ExampleParallel_statefulFunnelsThroughPipe checks that a stateful xf
+// (Dedupe here) still produces correct results when Parallel is asked
+// for more than one worker, by funneling the whole stream through Pipe
+// instead of splitting its accumulator across the pool.
+func ExampleParallel_statefulFunnelsThroughPipe() {
+	ctx := context.Background()
+	out := Parallel(ctx, transduce.Dedupe(), ints(ctx, 1, 1, 2, 2, 3), 4, false)
+	var got []interface{}
+	for v := range out {
+		got = append(got, v)
+	}
+	fmt.Println(got)
+	// Output: [1 2 3]
+}
+
+// ExampleParallel_composedStatefulFunnelsThroughPipe checks that
+// Transducer.Compose preserves the stateful mark of its operands, so a
+// stateful transducer hidden behind Compose is still funneled through
+// Pipe instead of silently being fanned out across several workers.
+func ExampleParallel_composedStatefulFunnelsThroughPipe() {
+	ctx := context.Background()
+	xf := transduce.Take(3).Compose(transduce.Map(func(x int) int { return x * 2 }))
+	out := Parallel(ctx, xf, ints(ctx, 1, 2, 3, 4, 5), 4, false)
+	var got []interface{}
+	for v := range out {
+		got = append(got, v)
+	}
+	fmt.Println(got)
+	// Output: [2 4 6]
+}
+
+// ExampleParallel_statefulMultiEmit checks a stateful transducer that
+// emits more than one value per input (Interpose, unlike Dedupe or
+// Take) still produces correct results through Parallel, which the
+// per-item worker loop used for stateless xf cannot handle -- this is
+// exactly why a stateful xf is funneled through Pipe instead of that
+// loop, regardless of how many workers are requested.
+func ExampleParallel_statefulMultiEmit() {
+	ctx := context.Background()
+	out := Parallel(ctx, transduce.Interpose(0), ints(ctx, 1, 2, 3), 4, false)
+	var got []interface{}
+	for v := range out {
+		got = append(got, v)
+	}
+	fmt.Println(got)
+	// Output: [1 0 2 0 3]
+}
+
+func ExampleTee() {
+	ctx := context.Background()
+	a := make(chan interface{})
+	b := make(chan interface{})
+	Tee(ctx, transduce.Map(func(x int) int { return x + 1 }), ints(ctx, 1, 2, 3), a, b)
+
+	var fromA, fromB []interface{}
+	done := make(chan struct{})
+	go func() {
+		for v := range a {
+			fromA = append(fromA, v)
+		}
+		close(done)
+	}()
+	for v := range b {
+		fromB = append(fromB, v)
+	}
+	<-done
+	fmt.Println(fromA)
+	fmt.Println(fromB)
+	// Output:
+	// [2 3 4]
+	// [2 3 4]
+}
+
+func ExampleMerge() {
+	ctx := context.Background()
+	out := Merge(
+		ctx,
+		transduce.Map(func(x int) int { return x * 10 }),
+		ints(ctx, 1, 2),
+		ints(ctx, 3, 4),
+	)
+	var got []int
+	for v := range out {
+		got = append(got, v.(int))
+	}
+	sort.Ints(got)
+	fmt.Println(got)
+	// Output: [10 20 30 40]
+}
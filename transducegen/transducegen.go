@@ -0,0 +1,440 @@
+// Package transducegen mirrors the reflection-based API in
+// jsouthworth.net/go/transduce with a generics-based one. Transducer and
+// ReducerFn are parameterized over concrete Go types instead of
+// interface{}, so pipelines built from this package avoid both the
+// interface{} boxing and the dyn.Apply reflection dispatch that the
+// parent package pays on every Step call. The reflection-based API in the
+// parent package is unaffected; use this package when a pipeline's types
+// are known at compile time and the per-element cost matters.
+package transducegen
+
+// Reduced marks the accumulator of a reduction as having terminated
+// early. It plays the same role as transduce.Reduced, but as a generic
+// wrapper around the concrete accumulator type R instead of interface{}.
+type Reduced[R any] struct {
+	Val  R
+	done bool
+}
+
+// Done wraps val to signal that the reduction should stop after this
+// step.
+func Done[R any](val R) Reduced[R] {
+	return Reduced[R]{Val: val, done: true}
+}
+
+// NotDone wraps val as an in-progress accumulator.
+func NotDone[R any](val R) Reduced[R] {
+	return Reduced[R]{Val: val}
+}
+
+// IsReduced reports whether r has been marked done.
+func IsReduced[R any](r Reduced[R]) bool {
+	return r.done
+}
+
+// EnsureReduced marks r done if it is not already.
+func EnsureReduced[R any](r Reduced[R]) Reduced[R] {
+	r.done = true
+	return r
+}
+
+// Unreduced strips the done marking from r, returning r with its
+// underlying value unmodified.
+func Unreduced[R any](r Reduced[R]) Reduced[R] {
+	r.done = false
+	return r
+}
+
+// ReducerFn is the generic analogue of transduce.ReducerFn. R is the
+// accumulator type threaded through Init/Result/Step; I is the element
+// type consumed by Step. Fixing both at compile time lets the compiler
+// inline Step instead of dispatching through dyn.Apply.
+type ReducerFn[R, I any] interface {
+	// Init returns an initial value for a reduction.
+	Init() Reduced[R]
+	// Result returns the result of the reduction.
+	Result(result Reduced[R]) Reduced[R]
+	// Step computes one step of the reduction.
+	Step(result Reduced[R], input I) Reduced[R]
+}
+
+type reducer[R, I any] struct {
+	init   func() Reduced[R]
+	result func(Reduced[R]) Reduced[R]
+	step   func(Reduced[R], I) Reduced[R]
+}
+
+func (r *reducer[R, I]) Init() Reduced[R]                       { return r.init() }
+func (r *reducer[R, I]) Result(result Reduced[R]) Reduced[R]    { return r.result(result) }
+func (r *reducer[R, I]) Step(result Reduced[R], i I) Reduced[R] { return r.step(result, i) }
+
+// Reducer constructs a ReducerFn from its three constituent functions.
+func Reducer[R, I any](
+	init func() Reduced[R],
+	result func(Reduced[R]) Reduced[R],
+	step func(Reduced[R], I) Reduced[R],
+) ReducerFn[R, I] {
+	return &reducer[R, I]{init: init, result: result, step: step}
+}
+
+// Completing returns a ReducerFn with a standard Init and Result
+// function, analogous to transduce.Completing.
+func Completing[R, I any](step func(Reduced[R], I) Reduced[R]) ReducerFn[R, I] {
+	return Reducer(
+		func() Reduced[R] {
+			var zero R
+			return NotDone(zero)
+		},
+		func(result Reduced[R]) Reduced[R] { return result },
+		step,
+	)
+}
+
+// Transducer is the generic analogue of transduce.Transducer. R is the
+// accumulator type shared by every stage of a pipeline; In and Out are
+// the element types this stage consumes and produces. Go's type system
+// has no way to abstract over R while still letting Transducer be a
+// composable function value (the "type-lambda" transduce would need), so
+// R is carried as an explicit type parameter and stages are joined with
+// Compose2/Compose3 instead of a variadic Compose.
+type Transducer[R, In, Out any] func(ReducerFn[R, Out]) ReducerFn[R, In]
+
+// Compose2 composes two generic transducers: Compose2(t1, t2)(rf) ==
+// t1(t2(rf)).
+func Compose2[R, A, B, C any](t1 Transducer[R, A, B], t2 Transducer[R, B, C]) Transducer[R, A, C] {
+	return func(rf ReducerFn[R, C]) ReducerFn[R, A] {
+		return t1(t2(rf))
+	}
+}
+
+// Compose3 composes three generic transducers in sequence.
+func Compose3[R, A, B, C, D any](
+	t1 Transducer[R, A, B],
+	t2 Transducer[R, B, C],
+	t3 Transducer[R, C, D],
+) Transducer[R, A, D] {
+	return Compose2(t1, Compose2(t2, t3))
+}
+
+// Compose4 composes four generic transducers in sequence.
+func Compose4[R, A, B, C, D, E any](
+	t1 Transducer[R, A, B],
+	t2 Transducer[R, B, C],
+	t3 Transducer[R, C, D],
+	t4 Transducer[R, D, E],
+) Transducer[R, A, E] {
+	return Compose2(t1, Compose3(t2, t3, t4))
+}
+
+// reducing is the generic analogue of transduce.Reducing: it builds a
+// ReducerFn that only overrides the Step function, reusing rf's Init and
+// Result.
+func reducing[R, In, Out any](rf ReducerFn[R, Out], step func(Reduced[R], In) Reduced[R]) ReducerFn[R, In] {
+	return Reducer(rf.Init, rf.Result, step)
+}
+
+// Map returns a transducer that replaces each element of a stream with
+// f(element).
+func Map[R, In, Out any](f func(In) Out) Transducer[R, In, Out] {
+	return func(rf ReducerFn[R, Out]) ReducerFn[R, In] {
+		return reducing[R, In](rf, func(result Reduced[R], input In) Reduced[R] {
+			return rf.Step(result, f(input))
+		})
+	}
+}
+
+// Filter returns a transducer that skips elements of a stream for which
+// pred returns false.
+func Filter[R, T any](pred func(T) bool) Transducer[R, T, T] {
+	return func(rf ReducerFn[R, T]) ReducerFn[R, T] {
+		return reducing[R, T](rf, func(result Reduced[R], input T) Reduced[R] {
+			if pred(input) {
+				return rf.Step(result, input)
+			}
+			return result
+		})
+	}
+}
+
+// Remove returns a transducer that skips elements of a stream for which
+// pred returns true.
+func Remove[R, T any](pred func(T) bool) Transducer[R, T, T] {
+	return Filter[R, T](func(t T) bool { return !pred(t) })
+}
+
+// Take returns a stateful transducer that ends processing of a stream
+// after n elements.
+func Take[R, T any](n int) Transducer[R, T, T] {
+	return func(rf ReducerFn[R, T]) ReducerFn[R, T] {
+		count := n
+		return reducing[R, T](rf, func(result Reduced[R], input T) Reduced[R] {
+			current := count
+			count--
+			if current > 0 {
+				result = rf.Step(result, input)
+			}
+			if count > 0 {
+				return result
+			}
+			return EnsureReduced(result)
+		})
+	}
+}
+
+// TakeWhile returns a transducer that ends processing of a stream once
+// pred becomes false.
+func TakeWhile[R, T any](pred func(T) bool) Transducer[R, T, T] {
+	return func(rf ReducerFn[R, T]) ReducerFn[R, T] {
+		return reducing[R, T](rf, func(result Reduced[R], input T) Reduced[R] {
+			if pred(input) {
+				return rf.Step(result, input)
+			}
+			return EnsureReduced(result)
+		})
+	}
+}
+
+// TakeNth returns a stateful transducer that skips all elements of a
+// stream whose index is not divisible by n.
+func TakeNth[R, T any](n int) Transducer[R, T, T] {
+	return func(rf ReducerFn[R, T]) ReducerFn[R, T] {
+		count := 0
+		return reducing[R, T](rf, func(result Reduced[R], input T) Reduced[R] {
+			count++
+			if count%n == 0 {
+				return rf.Step(result, input)
+			}
+			return result
+		})
+	}
+}
+
+// Drop returns a stateful transducer that skips the first n elements of
+// a stream and processes the rest.
+func Drop[R, T any](n int) Transducer[R, T, T] {
+	return func(rf ReducerFn[R, T]) ReducerFn[R, T] {
+		dropped := 0
+		return reducing[R, T](rf, func(result Reduced[R], input T) Reduced[R] {
+			if dropped < n {
+				dropped++
+				return result
+			}
+			return rf.Step(result, input)
+		})
+	}
+}
+
+// DropWhile returns a stateful transducer that skips all elements of a
+// stream until pred returns false, then processes the rest.
+func DropWhile[R, T any](pred func(T) bool) Transducer[R, T, T] {
+	return func(rf ReducerFn[R, T]) ReducerFn[R, T] {
+		drop := true
+		return reducing[R, T](rf, func(result Reduced[R], input T) Reduced[R] {
+			if drop && pred(input) {
+				return result
+			}
+			drop = false
+			return rf.Step(result, input)
+		})
+	}
+}
+
+// Keep returns a transducer that keeps f(element) for every element
+// where f does not return the zero value of Out, and skips the rest.
+func Keep[R, In, Out comparable](f func(In) Out) Transducer[R, In, Out] {
+	var zero Out
+	return func(rf ReducerFn[R, Out]) ReducerFn[R, In] {
+		return reducing[R, In](rf, func(result Reduced[R], input In) Reduced[R] {
+			ret := f(input)
+			if ret != zero {
+				return rf.Step(result, ret)
+			}
+			return result
+		})
+	}
+}
+
+// KeepIndexed returns a stateful transducer that keeps f(index, element)
+// for every element where f does not return the zero value of Out, and
+// skips the rest.
+func KeepIndexed[R, In, Out comparable](f func(int, In) Out) Transducer[R, In, Out] {
+	var zero Out
+	return func(rf ReducerFn[R, Out]) ReducerFn[R, In] {
+		index := 0
+		return reducing[R, In](rf, func(result Reduced[R], input In) Reduced[R] {
+			ret := f(index, input)
+			index++
+			if ret != zero {
+				return rf.Step(result, ret)
+			}
+			return result
+		})
+	}
+}
+
+// Dedupe returns a stateful transducer that deduplicates adjacent
+// elements of a stream.
+func Dedupe[R, T comparable]() Transducer[R, T, T] {
+	return func(rf ReducerFn[R, T]) ReducerFn[R, T] {
+		var prior T
+		first := true
+		return reducing[R, T](rf, func(result Reduced[R], input T) Reduced[R] {
+			if !first && prior == input {
+				return result
+			}
+			first = false
+			prior = input
+			return rf.Step(result, input)
+		})
+	}
+}
+
+// PartitionAll returns a stateful transducer that partitions a stream
+// into n sized buckets. The final bucket may be smaller than n if the
+// number of elements is not divisible by n.
+func PartitionAll[R, T any](n int) Transducer[R, T, []T] {
+	return func(rf ReducerFn[R, []T]) ReducerFn[R, T] {
+		part := make([]T, 0, n)
+		return Reducer(
+			func() Reduced[R] { return rf.Init() },
+			func(result Reduced[R]) Reduced[R] {
+				ret := result
+				if len(part) > 0 {
+					cpy := make([]T, len(part))
+					copy(cpy, part)
+					part = part[:0]
+					ret = Unreduced(rf.Step(result, cpy))
+				}
+				return rf.Result(ret)
+			},
+			func(result Reduced[R], input T) Reduced[R] {
+				part = append(part, input)
+				if n == len(part) {
+					cpy := make([]T, len(part))
+					copy(cpy, part)
+					part = part[:0]
+					return rf.Step(result, cpy)
+				}
+				return result
+			},
+		)
+	}
+}
+
+// PartitionBy returns a stateful transducer that partitions a stream
+// whenever f returns a different result than it did for the previous
+// element.
+func PartitionBy[R, T any, K comparable](f func(T) K) Transducer[R, T, []T] {
+	return func(rf ReducerFn[R, []T]) ReducerFn[R, T] {
+		var part []T
+		var prior K
+		haveKey := false
+		return Reducer(
+			func() Reduced[R] { return rf.Init() },
+			func(result Reduced[R]) Reduced[R] {
+				ret := result
+				if len(part) > 0 {
+					cpy := make([]T, len(part))
+					copy(cpy, part)
+					part = nil
+					ret = Unreduced(rf.Step(result, cpy))
+				}
+				return rf.Result(ret)
+			},
+			func(result Reduced[R], input T) Reduced[R] {
+				key := f(input)
+				same := haveKey && key == prior
+				prior, haveKey = key, true
+				if same || len(part) == 0 {
+					part = append(part, input)
+					return result
+				}
+				cpy := make([]T, len(part))
+				copy(cpy, part)
+				part = nil
+				ret := rf.Step(result, cpy)
+				if !IsReduced(ret) {
+					part = append(part, input)
+				}
+				return ret
+			},
+		)
+	}
+}
+
+// Interpose returns a stateful transducer that places sep between each
+// pair of elements in a stream.
+func Interpose[R, T any](sep T) Transducer[R, T, T] {
+	return func(rf ReducerFn[R, T]) ReducerFn[R, T] {
+		started := false
+		return reducing[R, T](rf, func(result Reduced[R], input T) Reduced[R] {
+			if started {
+				sepr := rf.Step(result, sep)
+				if IsReduced(sepr) {
+					return sepr
+				}
+				return rf.Step(sepr, input)
+			}
+			started = true
+			return rf.Step(result, input)
+		})
+	}
+}
+
+// Replace returns a transducer that replaces elements of a stream with
+// their corresponding entry in smap, leaving elements with no entry
+// unmodified.
+func Replace[R, T comparable](smap map[T]T) Transducer[R, T, T] {
+	return Map[R, T, T](func(in T) T {
+		if out, ok := smap[in]; ok {
+			return out
+		}
+		return in
+	})
+}
+
+// PreservingReduced wraps rf so that a Reduced result from rf.Step is
+// returned still marked done, preserving the termination signal one
+// level up for transducers (like Cat) that reduce over nested
+// collections. Reduced here is a flag rather than a nested wrapper, so
+// the result is not wrapped a second time.
+func PreservingReduced[R, T any](rf ReducerFn[R, T]) ReducerFn[R, T] {
+	return Reducer(rf.Init, rf.Result, func(result Reduced[R], input T) Reduced[R] {
+		ret := rf.Step(result, input)
+		if IsReduced(ret) {
+			return EnsureReduced(ret)
+		}
+		return ret
+	})
+}
+
+// Cat returns a transducer that concatenates the contents of each input
+// collection, traversed via reduce.
+func Cat[R, T any](reduce func(step func(Reduced[R], T) Reduced[R], result Reduced[R], in []T) Reduced[R]) Transducer[R, []T, T] {
+	return func(rf ReducerFn[R, T]) ReducerFn[R, []T] {
+		rrf := PreservingReduced(rf)
+		return reducing[R, []T](rf, func(result Reduced[R], input []T) Reduced[R] {
+			return reduce(rrf.Step, result, input)
+		})
+	}
+}
+
+// Mapcat composes Map and Cat: f is applied to each element and the
+// resulting collections are concatenated.
+func Mapcat[R, In, Out any](
+	reduce func(step func(Reduced[R], Out) Reduced[R], result Reduced[R], in []Out) Reduced[R],
+	f func(In) []Out,
+) Transducer[R, In, Out] {
+	return Compose2(Map[R, In, []Out](f), Cat[R, Out](reduce))
+}
+
+// RandomSample returns a transducer that processes a random sampling of
+// a stream, skipping all other elements. sample must match the signature
+// func() float64 and is expected to return a value in [0, 1); it is
+// passed in rather than called directly so callers can control the
+// source of randomness.
+func RandomSample[R, T any](prob float64, sample func() float64) Transducer[R, T, T] {
+	return Filter[R, T](func(_ T) bool {
+		return sample() < prob
+	})
+}
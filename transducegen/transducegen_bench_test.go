@@ -0,0 +1,70 @@
+package transducegen
+
+import (
+	"testing"
+
+	"jsouthworth.net/go/transduce"
+)
+
+func tenMillionInts() []int {
+	data := make([]int, 10_000_000)
+	for i := range data {
+		data[i] = i
+	}
+	return data
+}
+
+// BenchmarkGenericMapFilter exercises a Map+Filter pipeline built from
+// this package's generic Transducer, with In/Out fixed at compile time.
+func BenchmarkGenericMapFilter(b *testing.B) {
+	data := tenMillionInts()
+	xf := Compose2(
+		Map[int, int, int](func(x int) int { return x + 1 }),
+		Filter[int, int](func(x int) bool { return x%2 == 0 }),
+	)
+	rf := Completing[int, int](func(result Reduced[int], input int) Reduced[int] {
+		return NotDone(result.Val + input)
+	})
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		step := xf(rf)
+		result := step.Init()
+		for _, v := range data {
+			result = step.Step(result, v)
+			if IsReduced(result) {
+				break
+			}
+		}
+		_ = step.Result(result)
+	}
+}
+
+// BenchmarkReflectionMapFilter runs the equivalent pipeline through the
+// parent package's interface{}/dyn.Apply based Transducer, to quantify
+// the savings the generic path above provides.
+func BenchmarkReflectionMapFilter(b *testing.B) {
+	data := tenMillionInts()
+	xf := transduce.Compose(
+		transduce.Map(func(x int) int { return x + 1 }),
+		transduce.Filter(func(x int) bool { return x%2 == 0 }),
+	)
+	rf := transduce.Reducer(
+		func() interface{} { return 0 },
+		func(result interface{}) interface{} { return result },
+		func(result, input interface{}) interface{} {
+			return result.(int) + input.(int)
+		},
+	)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		step := xf(rf)
+		var result interface{} = step.Init()
+		for _, v := range data {
+			result = step.Step(result, v)
+			if transduce.IsReduced(result) {
+				break
+			}
+		}
+		_ = step.Result(result)
+	}
+}
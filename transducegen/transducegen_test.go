@@ -0,0 +1,87 @@
+package transducegen
+
+import "fmt"
+
+// collect drives vs through xf and returns every value xf emits,
+// collected into a slice. It mirrors the collector() helper in the
+// parent package's example tests, fixing the accumulator type R to
+// []Out the way Compose2/Compose3/Compose4 fix it to thread through a
+// pipeline.
+func collect[In, Out any](xf Transducer[[]Out, In, Out], vs []In) []Out {
+	rf := Reducer(
+		func() Reduced[[]Out] { return NotDone([]Out{}) },
+		func(result Reduced[[]Out]) Reduced[[]Out] { return result },
+		func(result Reduced[[]Out], input Out) Reduced[[]Out] {
+			return NotDone(append(result.Val, input))
+		},
+	)
+	step := xf(rf)
+	result := step.Init()
+	for _, v := range vs {
+		result = step.Step(result, v)
+		if IsReduced(result) {
+			break
+		}
+	}
+	return step.Result(result).Val
+}
+
+func ExampleTake() {
+	fmt.Println(collect[int, int](Take[[]int, int](3), []int{1, 2, 3, 4, 5}))
+	// Output: [1 2 3]
+}
+
+func ExamplePartitionAll() {
+	fmt.Println(collect[int, []int](PartitionAll[[][]int, int](3), []int{1, 2, 3, 4, 5, 6, 7}))
+	// Output: [[1 2 3] [4 5 6] [7]]
+}
+
+func ExamplePartitionBy() {
+	fmt.Println(collect[int, []int](
+		PartitionBy[[][]int, int](func(x int) bool { return x > 7 }),
+		[]int{1, 2, 7, 8, 9, 3, 4},
+	))
+	// Output: [[1 2 7] [8 9] [3 4]]
+}
+
+// sliceReduce is a reduce function for Cat/Mapcat that walks a []int via
+// ordinary iteration, used by the examples below.
+func sliceReduce(step func(Reduced[[]int], int) Reduced[[]int], result Reduced[[]int], in []int) Reduced[[]int] {
+	for _, v := range in {
+		result = step(result, v)
+		if IsReduced(result) {
+			break
+		}
+	}
+	return result
+}
+
+func ExampleCat() {
+	fmt.Println(collect[[]int, int](Cat[[]int, int](sliceReduce), [][]int{{1, 2}, {3}, {4, 5}}))
+	// Output: [1 2 3 4 5]
+}
+
+func ExampleMapcat() {
+	xf := Mapcat[[]int, int, int](sliceReduce, func(x int) []int { return []int{x, x * 10} })
+	fmt.Println(collect[int, int](xf, []int{1, 2, 3}))
+	// Output: [1 10 2 20 3 30]
+}
+
+func ExampleCompose2() {
+	xf := Compose2(
+		Map[[]int, int, int](func(x int) int { return x + 1 }),
+		Filter[[]int, int](func(x int) bool { return x%2 == 0 }),
+	)
+	fmt.Println(collect[int, int](xf, []int{1, 2, 3, 4, 5}))
+	// Output: [2 4 6]
+}
+
+func ExampleCompose3() {
+	xf := Compose3(
+		Map[[]int, int, int](func(x int) int { return x + 1 }),
+		Filter[[]int, int](func(x int) bool { return x%2 == 0 }),
+		Take[[]int, int](2),
+	)
+	fmt.Println(collect[int, int](xf, []int{1, 2, 3, 4, 5}))
+	// Output: [2 4]
+}
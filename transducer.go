@@ -14,9 +14,13 @@ type Transducer func(ReducerFn) ReducerFn
 
 // Compose is function composition of two Transducers.
 func (t Transducer) Compose(other Transducer) Transducer {
-	return func(s ReducerFn) ReducerFn {
+	xf := Transducer(func(s ReducerFn) ReducerFn {
 		return t(other(s))
+	})
+	if t.Stateful() || other.Stateful() {
+		xf = markStateful(xf)
 	}
+	return xf
 }
 
 // ReducerFn represents a reducing function. A reducer is set of functions of  0, 1 and 2 arity respectively. Here this is represented by an interface of three methods and a constructor to build a reified version of this from 3 passed in functions. This allows for a more functional style when writing most transducers.
@@ -228,7 +232,7 @@ func Remove(pred interface{}) Transducer {
 // Take returns a stateful transducer that will end processing of a
 // stream after n elements.
 func Take(n int) Transducer {
-	return func(rf ReducerFn) ReducerFn {
+	return markStateful(func(rf ReducerFn) ReducerFn {
 		count := n
 		return Reducing(
 			func(result, input interface{}) interface{} {
@@ -244,7 +248,7 @@ func Take(n int) Transducer {
 				return EnsureReduced(result)
 			},
 		)(rf)
-	}
+	})
 }
 
 // TakeWhile returns a transducer that will end processing of
@@ -252,7 +256,7 @@ func Take(n int) Transducer {
 // func(i iT) bool.
 func TakeWhile(pred interface{}) Transducer {
 	predFn := wrapPredicate(pred)
-	return func(rf ReducerFn) ReducerFn {
+	return markStateful(func(rf ReducerFn) ReducerFn {
 		return Reducing(
 			func(result, input interface{}) interface{} {
 				if predFn(input) {
@@ -261,13 +265,13 @@ func TakeWhile(pred interface{}) Transducer {
 				return EnsureReduced(result)
 			},
 		)(rf)
-	}
+	})
 }
 
 // TakeNth returns a stateful transducer that will skip all elements of
 // a stream whose index is not divisible by n.
 func TakeNth(n int) Transducer {
-	return func(rf ReducerFn) ReducerFn {
+	return markStateful(func(rf ReducerFn) ReducerFn {
 		count := 0
 		return Reducing(
 			func(result, input interface{}) interface{} {
@@ -278,13 +282,13 @@ func TakeNth(n int) Transducer {
 				return result
 			},
 		)(rf)
-	}
+	})
 }
 
 // Drop returns a stateful transducer that will skip the first n elements
 // of a stream and process the rest.
 func Drop(n int) Transducer {
-	return func(rf ReducerFn) ReducerFn {
+	return markStateful(func(rf ReducerFn) ReducerFn {
 		dropped := 0
 		return Reducing(
 			func(result, input interface{}) interface{} {
@@ -295,7 +299,7 @@ func Drop(n int) Transducer {
 				return rf.Step(result, input)
 			},
 		)(rf)
-	}
+	})
 }
 
 // DropWhile returns a stateful transducer that will skip all elements
@@ -303,7 +307,7 @@ func Drop(n int) Transducer {
 // pred must match the signature func(i iT) bool.
 func DropWhile(pred interface{}) Transducer {
 	predFn := wrapPredicate(pred)
-	return func(rf ReducerFn) ReducerFn {
+	return markStateful(func(rf ReducerFn) ReducerFn {
 		drop := true
 		return Reducing(
 			func(result, input interface{}) interface{} {
@@ -314,7 +318,7 @@ func DropWhile(pred interface{}) Transducer {
 				return rf.Step(result, input)
 			},
 		)(rf)
-	}
+	})
 }
 
 // Keep returns a transducer that will keep all non-nil elements of a stream
@@ -348,7 +352,7 @@ func KeepIndexed(f interface{}) Transducer {
 			return apply(f, idx, val)
 		}
 	}
-	return func(rf ReducerFn) ReducerFn {
+	return markStateful(func(rf ReducerFn) ReducerFn {
 		index := 0
 		return Reducing(
 			func(result, input interface{}) interface{} {
@@ -360,13 +364,13 @@ func KeepIndexed(f interface{}) Transducer {
 				return result
 			},
 		)(rf)
-	}
+	})
 }
 
 // Dedupe returns a stateful transducer that will deduplicate
 // adjacent elements of a stream.
 func Dedupe() Transducer {
-	return func(rf ReducerFn) ReducerFn {
+	return markStateful(func(rf ReducerFn) ReducerFn {
 		var prior interface{}
 		return Reducing(
 			func(result, input interface{}) interface{} {
@@ -377,7 +381,7 @@ func Dedupe() Transducer {
 				return rf.Step(result, input)
 			},
 		)(rf)
-	}
+	})
 }
 
 // RandomSample returns a transducer that will process a random sampling of
@@ -388,89 +392,62 @@ func RandomSample(prob float64) Transducer {
 	})
 }
 
+// partition is the batch accumulator used by PartitionBy: items holds
+// the elements seen so far in the current partition and key is the
+// classification of the most recently merged one.
+type partition struct {
+	items []interface{}
+	key   interface{}
+}
+
 // PartitionBy returns a stateful transducer that will partition a stream
 // when f returns a different result than previous result. f must match
-// the signature func(i iT) oT.
+// the signature func(i iT) oT. Implemented in terms of Batch.
 func PartitionBy(f interface{}) Transducer {
 	mapFn := wrapMapper(f)
-	return func(rf ReducerFn) ReducerFn {
-		part := []interface{}{}
-		var mark int
-		var prior interface{} = &mark
-		return Reducer(
-			func() interface{} {
-				return rf.Init()
-			},
-			func(result interface{}) interface{} {
-				ret := result
-				if len(part) > 0 {
-					cpy := make([]interface{}, len(part))
-					copy(cpy, part)
-					part = []interface{}{}
-					ret = Unreduced(rf.Step(result, cpy))
-				}
-				return rf.Result(ret)
-			},
-			func(result, input interface{}) interface{} {
-				val := mapFn(input)
-				pval := prior
-				prior = val
-				if pval == &mark || pval == val {
-					part = append(part, input)
-					return result
-				} else {
-					cpy := make([]interface{}, len(part))
-					copy(cpy, part)
-					part = []interface{}{}
-					ret := rf.Step(result, cpy)
-					if !IsReduced(ret) {
-						part = append(part, input)
-					}
-					return ret
-				}
-			},
-		)
-	}
+	return Batch(
+		func(p partition, input interface{}) bool {
+			return mapFn(input) != p.key
+		},
+		func(p partition, input interface{}) partition {
+			return partition{
+				items: append(p.items, input),
+				key:   mapFn(input),
+			}
+		},
+		func(p partition) interface{} {
+			cpy := make([]interface{}, len(p.items))
+			copy(cpy, p.items)
+			return cpy
+		},
+		partition{},
+	)
 }
 
 // PartitionAll returns a stateful transducer that will partition a stream
 // into n sized buckets. The final bucket may be smaller than n if the number of
-// elements is not divisible by n.
+// elements is not divisible by n. Implemented in terms of Batch.
 func PartitionAll(n int) Transducer {
-	return func(rf ReducerFn) ReducerFn {
-		part := make([]interface{}, 0, n)
-		return Reducer(
-			func() interface{} {
-				return rf.Init()
-			},
-			func(result interface{}) interface{} {
-				ret := result
-				if len(part) > 0 {
-					cpy := make([]interface{}, len(part))
-					copy(cpy, part)
-					part = make([]interface{}, 0, n)
-					ret = rf.Step(result, cpy)
-				}
-				return rf.Result(ret)
-			},
-			func(result, input interface{}) interface{} {
-				part = append(part, input)
-				if n == len(part) {
-					cpy := make([]interface{}, len(part))
-					copy(cpy, part)
-					part = make([]interface{}, 0, n)
-					return rf.Step(result, cpy)
-				}
-				return result
-			},
-		)
-	}
+	return Batch(
+		func(part []interface{}, _ interface{}) bool {
+			return len(part) >= n
+		},
+		func(part []interface{}, input interface{}) []interface{} {
+			return append(part, input)
+		},
+		func(part []interface{}) interface{} {
+			cpy := make([]interface{}, len(part))
+			copy(cpy, part)
+			return cpy
+		},
+		[]interface{}{},
+	)
 }
 
 // Interpose is a stateful transducer that will place an element
 // between each element in a stream.
 func Interpose(sep interface{}) Transducer {
-	return func(rf ReducerFn) ReducerFn {
+	return markStateful(func(rf ReducerFn) ReducerFn {
 		started := false
 		return Reducing(
 			func(result, input interface{}) interface{} {
@@ -485,7 +462,7 @@ func Interpose(sep interface{}) Transducer {
 				return rf.Step(result, input)
 			},
 		)(rf)
-	}
+	})
 }
 
 // Cat returns a transducer that will concatenate the contents of each input.
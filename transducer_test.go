@@ -1,5 +1,7 @@
 package transduce
 
+import "fmt"
+
 func ExampleReducer() {
 	// This reducer will drop all inputs and complete the reduction
 	_ = Reducer(
@@ -113,10 +115,36 @@ func ExamplePartitionBy() {
 	})
 }
 
+// ExamplePartitionBy_assertsOutput drives PartitionBy through Into to
+// validate the Batch-based rewrite actually partitions on classifier
+// changes, rather than just constructing the transducer.
+func ExamplePartitionBy_assertsOutput() {
+	result := Into(
+		[]interface{}{},
+		PartitionBy(func(x int) bool { return x > 7 }),
+		[]int{1, 2, 7, 8, 9, 3, 4},
+	)
+	fmt.Println(result)
+	// Output: [[1 2 7] [8 9] [3 4]]
+}
+
 func ExamplePartitionAll() {
 	_ = PartitionAll(3)
 }
 
+// ExamplePartitionAll_assertsOutput drives PartitionAll through Into to
+// validate the Batch-based rewrite actually buckets into fixed-size
+// partitions, rather than just constructing the transducer.
+func ExamplePartitionAll_assertsOutput() {
+	result := Into(
+		[]interface{}{},
+		PartitionAll(3),
+		[]int{1, 2, 3, 4, 5, 6, 7},
+	)
+	fmt.Println(result)
+	// Output: [[1 2 3] [4 5 6] [7]]
+}
+
 func ExampleInterpose() {
 	_ = Interpose("/")
 }